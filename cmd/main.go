@@ -5,6 +5,7 @@ import (
 
 	"extract-app/internal/browser"
 	"extract-app/internal/config"
+	"extract-app/internal/output"
 	"extract-app/internal/scraper"
 )
 
@@ -32,13 +33,20 @@ func main() {
 	// Create scraper
 	scraper := scraper.New(ctx, cfg)
 
+	if sink, err := output.New(cfg.OutputFormat, cfg.OutputPath); err != nil {
+		log.Fatalf("Failed to set up output sink: %v", err)
+	} else if sink != nil {
+		log.Printf("Writing output as %s to %s", cfg.OutputFormat, cfg.OutputPath)
+		scraper.SetSink(sink)
+	}
+
 	log.Println("Starting scraping process...")
 
 	// Run scraper
-	_, err := scraper.GetOptions(cfg.URL)
+	count, err := scraper.GetOptions(cfg.URL)
 	if err != nil {
 		log.Fatalf("Scraping failed: %v", err)
 	}
 
-	log.Println("Scraping completed successfully")
+	log.Printf("Scraping completed successfully, wrote %d racquets", count)
 }
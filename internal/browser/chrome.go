@@ -2,17 +2,66 @@ package browser
 
 import (
 	"context"
+	"encoding/json"
 	"log"
+	"os"
 
 	"extract-app/internal/config"
 
+	"github.com/chromedp/cdproto/emulation"
+	"github.com/chromedp/cdproto/network"
+	"github.com/chromedp/cdproto/page"
 	"github.com/chromedp/chromedp"
 )
 
+// defaultStrictUserAgent is pinned so the UA string, platform, and the
+// sec-ch-ua client hints Chrome derives from it all describe the same,
+// ordinary-looking desktop build.
+const defaultStrictUserAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+
+// defaultStrictPlatform and defaultStrictUserAgentMetadata describe the same
+// Chrome build as defaultStrictUserAgent, so navigator.platform and the
+// sec-ch-ua/sec-ch-ua-platform client hints agree with the UA string instead
+// of leaking the real underlying build when --strict is set.
+const defaultStrictPlatform = "Windows"
+
+var defaultStrictUserAgentMetadata = &emulation.UserAgentMetadata{
+	Brands: []*emulation.UserAgentBrandVersion{
+		{Brand: "Not/A)Brand", Version: "8"},
+		{Brand: "Chromium", Version: "126"},
+		{Brand: "Google Chrome", Version: "126"},
+	},
+	FullVersionList: []*emulation.UserAgentBrandVersion{
+		{Brand: "Not/A)Brand", Version: "8.0.0.0"},
+		{Brand: "Chromium", Version: "126.0.0.0"},
+		{Brand: "Google Chrome", Version: "126.0.0.0"},
+	},
+	Platform:        defaultStrictPlatform,
+	PlatformVersion: "10.0.0",
+	Architecture:    "x86",
+	Bitness:         "64",
+	Mobile:          false,
+}
+
+// resolveUserAgent returns the UA string to launch Chrome with: cfg.UserAgent
+// if set, else defaultStrictUserAgent when cfg.Strict, else "" (leave Chrome's
+// own UA alone).
+func resolveUserAgent(cfg *config.Config) string {
+	if cfg.UserAgent != "" {
+		return cfg.UserAgent
+	}
+	if cfg.Strict {
+		return defaultStrictUserAgent
+	}
+	return ""
+}
+
 func NewChrome(cfg *config.Config) (context.Context, context.CancelFunc) {
 	// Create base context
 	baseCtx := context.Background()
 
+	userAgent := resolveUserAgent(cfg)
+
 	opts := append(chromedp.DefaultExecAllocatorOptions[:],
 		chromedp.NoFirstRun,
 		chromedp.NoDefaultBrowserCheck,
@@ -47,6 +96,10 @@ func NewChrome(cfg *config.Config) (context.Context, context.CancelFunc) {
 		chromedp.Flag("no-sandbox", true),
 	)
 
+	if userAgent != "" {
+		opts = append(opts, chromedp.UserAgent(userAgent))
+	}
+
 	// Create allocator context
 	allocCtx, allocCancel := chromedp.NewExecAllocator(baseCtx, opts...)
 
@@ -79,5 +132,83 @@ func NewChrome(cfg *config.Config) (context.Context, context.CancelFunc) {
 		return nil, func() {}
 	}
 
+	if err := ApplyTargetSetup(timeoutCtx, cfg); err != nil {
+		log.Printf("Failed to apply target setup: %v", err)
+		cancelFunc()
+		return nil, func() {}
+	}
+
 	return timeoutCtx, cancelFunc
 }
+
+// ApplyTargetSetup wires up dialog auto-dismissal, stealth patches, and
+// cookie loading against ctx's CDP target. chromedp.ListenTarget and
+// page.AddScriptToEvaluateOnNewDocument are both per-target, not global, so
+// NewChrome applies this to its initial tab and any caller that opens a
+// further target (chunk0-2's per-option worker tabs) must call it again
+// against that target's own context.
+func ApplyTargetSetup(ctx context.Context, cfg *config.Config) error {
+	// Auto-accept any JS dialog (alert/confirm/beforeunload) the target page
+	// throws up, so a surprise modal doesn't stall the whole scrape.
+	chromedp.ListenTarget(ctx, func(ev interface{}) {
+		if dialog, ok := ev.(*page.EventJavascriptDialogOpening); ok {
+			log.Printf("Auto-dismissing JS dialog (%s): %s", dialog.Type, dialog.Message)
+			go func() {
+				if err := chromedp.Run(ctx, page.HandleJavaScriptDialog(true)); err != nil {
+					log.Printf("Failed to auto-dismiss dialog: %v", err)
+				}
+			}()
+		}
+	})
+
+	actions := []chromedp.Action{page.Enable()}
+
+	// --strict pins the UA string, navigator.platform, and the sec-ch-ua
+	// client hints together so they all describe the same Chrome build,
+	// instead of only overriding the UA string at launch and leaving the
+	// real build's client hints to leak through.
+	if cfg.Strict {
+		userAgent := resolveUserAgent(cfg)
+		actions = append(actions, emulation.SetUserAgentOverride(userAgent).
+			WithPlatform(defaultStrictPlatform).
+			WithUserAgentMetadata(defaultStrictUserAgentMetadata))
+	}
+
+	if cfg.AntiDetection {
+		log.Println("Anti-detection enabled, injecting stealth patches...")
+		actions = append(actions, chromedp.ActionFunc(func(ctx context.Context) error {
+			_, err := page.AddScriptToEvaluateOnNewDocument(stealthJS).Do(ctx)
+			return err
+		}))
+	}
+
+	if cfg.CookiesPath != "" {
+		cookies, err := loadCookies(cfg.CookiesPath)
+		if err != nil {
+			log.Printf("Failed to load cookies from %s: %v", cfg.CookiesPath, err)
+		} else {
+			log.Printf("Loaded %d cookies from %s", len(cookies), cfg.CookiesPath)
+			actions = append(actions, chromedp.ActionFunc(func(ctx context.Context) error {
+				return network.SetCookies(cookies).Do(ctx)
+			}))
+		}
+	}
+
+	return chromedp.Run(ctx, actions...)
+}
+
+// loadCookies reads a JSON file of cookie definitions (shaped like
+// network.CookieParam) so a scrape can resume behind a logged-in session.
+func loadCookies(path string) ([]*network.CookieParam, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var cookies []*network.CookieParam
+	if err := json.Unmarshal(data, &cookies); err != nil {
+		return nil, err
+	}
+
+	return cookies, nil
+}
@@ -0,0 +1,30 @@
+package browser
+
+// stealthJS is the standard puppeteer-extra-stealth set of patches: it hides
+// the automation flag, fills in the plugin/language lists a real browser
+// carries, papers over the headless-only `window.chrome` gap, and reports
+// regular-looking WebGL vendor/renderer strings. It's evaluated via
+// page.AddScriptToEvaluateOnNewDocument so it runs before a target page's own
+// scripts get a chance to fingerprint us.
+const stealthJS = `
+(() => {
+	Object.defineProperty(navigator, 'webdriver', { get: () => undefined });
+
+	Object.defineProperty(navigator, 'plugins', {
+		get: () => [1, 2, 3, 4, 5].map(() => ({ name: 'Chrome PDF Plugin' })),
+	});
+
+	Object.defineProperty(navigator, 'languages', {
+		get: () => ['en-US', 'en'],
+	});
+
+	window.chrome = window.chrome || { runtime: {} };
+
+	const getParameter = WebGLRenderingContext.prototype.getParameter;
+	WebGLRenderingContext.prototype.getParameter = function (parameter) {
+		if (parameter === 37445) return 'Intel Inc.';
+		if (parameter === 37446) return 'Intel Iris OpenGL Engine';
+		return getParameter.call(this, parameter);
+	};
+})()
+`
@@ -0,0 +1,86 @@
+package checkpoint
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"os"
+)
+
+// State is the crash-recoverable progress of a single crawl: which URL the
+// run is against, the full option list discovered at the start, which option
+// indices have fully finished, and a dedup set of racquet names already
+// emitted (so a resumed run doesn't write the same racquet twice).
+type State struct {
+	URL           string
+	Options       []string
+	Completed     map[int]bool
+	EmittedHashes map[string]bool
+}
+
+// NewState builds an empty State for a fresh crawl against url.
+func NewState(url string, options []string) *State {
+	return &State{
+		URL:           url,
+		Options:       options,
+		Completed:     make(map[int]bool),
+		EmittedHashes: make(map[string]bool),
+	}
+}
+
+// HashName returns a short, stable key for a racquet name, used as the
+// dedup key in State.EmittedHashes.
+func HashName(name string) string {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return fmt.Sprintf("%x", h.Sum64())
+}
+
+// Checkpointer persists and restores crawl State so a crashed or timed-out
+// run can resume instead of starting over.
+type Checkpointer interface {
+	Load(url string) (*State, bool, error)
+	Save(state *State) error
+}
+
+// FileCheckpointer is the default Checkpointer: it stores State as a single
+// JSON file. A checkpoint only applies to the URL it was written for, so
+// pointing --resume at a stale file for a different URL is a no-op rather
+// than a mismatched resume.
+type FileCheckpointer struct {
+	path string
+}
+
+// NewFileCheckpointer builds a Checkpointer backed by the JSON file at path.
+func NewFileCheckpointer(path string) *FileCheckpointer {
+	return &FileCheckpointer{path: path}
+}
+
+func (f *FileCheckpointer) Load(url string) (*State, bool, error) {
+	data, err := os.ReadFile(f.path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("reading checkpoint %s: %w", f.path, err)
+	}
+
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, false, fmt.Errorf("parsing checkpoint %s: %w", f.path, err)
+	}
+
+	if state.URL != url {
+		return nil, false, nil
+	}
+
+	return &state, true, nil
+}
+
+func (f *FileCheckpointer) Save(state *State) error {
+	data, err := json.MarshalIndent(state, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding checkpoint: %w", err)
+	}
+	return os.WriteFile(f.path, data, 0644)
+}
@@ -6,11 +6,26 @@ import (
 )
 
 type Config struct {
-	URL           string
-	Headless      bool
-	Debug         bool
-	GlobalTimeout time.Duration // Overall timeout
-	ActionTimeout time.Duration // Timeout for individual actions
+	URL            string
+	Adapter        string // Registered scraper.Job to run (defaults to "tenniswarehouse")
+	Headless       bool
+	Debug          bool
+	GlobalTimeout  time.Duration // Overall timeout
+	ActionTimeout  time.Duration // Timeout for individual actions
+	Concurrency    int           // Number of options processed in parallel tabs (1 = serial)
+	ThrottleNum    int           // Max option starts per ThrottleWindow (0 disables throttling)
+	ThrottleWindow time.Duration // Window over which ThrottleNum applies
+	AntiDetection  bool          // Inject stealth patches (navigator.webdriver, plugins, WebGL, ...)
+	UserAgent      string        // Override the UA chromedp would otherwise send
+	CookiesPath    string        // Path to a JSON file of cookies applied before navigation
+	Strict         bool          // Pin UA + platform + sec-ch-ua to match the chosen Chrome build
+	OutputFormat   string        // "csv", "json", "jsonl", or "sqlite" ("" disables output)
+	OutputPath     string        // Destination file for OutputFormat
+	Resume         bool          // Skip options already completed in CheckpointPath
+	CheckpointPath string        // Path to the checkpoint file ("" disables checkpointing)
+	MaxRetries     int           // Retries per action on timeout/transient error (0 disables retrying)
+	BackoffBase    time.Duration // Base delay for retry backoff, doubled per attempt plus jitter
+	PreferHTTP     bool          // Try a plain HTTP GET before driving a browser tab, where the adapter supports it
 }
 
 func Parse() *Config {
@@ -18,8 +33,23 @@ func Parse() *Config {
 
 	// Define flags
 	flag.StringVar(&cfg.URL, "url", "", "Website URL to scrape (required)")
+	flag.StringVar(&cfg.Adapter, "adapter", "tenniswarehouse", "Registered site adapter to use")
 	flag.BoolVar(&cfg.Headless, "headless", false, "Run in headless mode")
 	flag.BoolVar(&cfg.Debug, "debug", false, "Enable debug mode")
+	flag.IntVar(&cfg.Concurrency, "concurrency", 1, "Number of options to process concurrently, each in its own tab")
+	flag.IntVar(&cfg.ThrottleNum, "throttle-num", 0, "Max option starts per --throttle-duration (0 disables throttling)")
+	throttleWindow := flag.Int("throttle-duration", 1, "Window in seconds over which --throttle-num applies")
+	flag.BoolVar(&cfg.AntiDetection, "anti-detection", false, "Inject stealth patches to evade basic bot detection")
+	flag.StringVar(&cfg.UserAgent, "user-agent", "", "Override the browser's User-Agent string")
+	flag.StringVar(&cfg.CookiesPath, "cookies", "", "Path to a JSON file of cookies to load before navigation")
+	flag.BoolVar(&cfg.Strict, "strict", false, "Pin UA, platform, and sec-ch-ua client hints to match the chosen Chrome build")
+	flag.StringVar(&cfg.OutputFormat, "output-format", "", "Output sink format: csv, json, jsonl, or sqlite (empty disables output)")
+	flag.StringVar(&cfg.OutputPath, "output-path", "racquets.out", "Destination file for --output-format")
+	flag.BoolVar(&cfg.Resume, "resume", false, "Resume from --checkpoint-path if it matches this --url")
+	flag.StringVar(&cfg.CheckpointPath, "checkpoint-path", "", "Path to a checkpoint file (empty disables checkpointing)")
+	flag.IntVar(&cfg.MaxRetries, "max-retries", 0, "Retries per action on timeout/transient error (0 disables retrying)")
+	backoffBase := flag.Int("backoff-base", 1, "Base delay in seconds for retry backoff, doubled per attempt plus jitter")
+	flag.BoolVar(&cfg.PreferHTTP, "prefer-http", false, "Try a plain HTTP GET before driving a browser tab, where the adapter supports it")
 
 	// Timeout flags (in minutes)
 	globalTimeout := flag.Int("timeout", 30, "Global timeout in minutes")
@@ -30,6 +60,12 @@ func Parse() *Config {
 	// Convert timeouts to time.Duration
 	cfg.GlobalTimeout = time.Duration(*globalTimeout) * time.Minute
 	cfg.ActionTimeout = time.Duration(*actionTimeout) * time.Minute
+	cfg.ThrottleWindow = time.Duration(*throttleWindow) * time.Second
+	cfg.BackoffBase = time.Duration(*backoffBase) * time.Second
+
+	if cfg.Concurrency < 1 {
+		cfg.Concurrency = 1
+	}
 
 	return cfg
 }
@@ -0,0 +1,16 @@
+package fetcher
+
+import (
+	"context"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Fetcher retrieves and parses a result page's HTML into a goquery.Document.
+// HTTPFetcher is the only implementation: Scraper tries it first when a Job
+// supports it, falling back to driving a chromedp tab directly (not through
+// this interface, since that fallback is a multi-step interaction rather
+// than a single fetch) when the page turns out to need JS to render its rows.
+type Fetcher interface {
+	Fetch(ctx context.Context) (*goquery.Document, error)
+}
@@ -0,0 +1,54 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// HTTPFetcher fetches a result page with a plain GET, carrying the same
+// User-Agent and cookies as the browser session. It's an order of magnitude
+// cheaper than driving a full Chrome tab for pages that render server-side.
+type HTTPFetcher struct {
+	URL       string
+	UserAgent string
+	Cookies   []*http.Cookie
+	Client    *http.Client
+}
+
+func (f *HTTPFetcher) Fetch(ctx context.Context) (*goquery.Document, error) {
+	client := f.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.URL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building request for %s: %w", f.URL, err)
+	}
+	if f.UserAgent != "" {
+		req.Header.Set("User-Agent", f.UserAgent)
+	}
+	for _, c := range f.Cookies {
+		req.AddCookie(c)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching %s: %w", f.URL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status %d fetching %s", resp.StatusCode, f.URL)
+	}
+
+	doc, err := goquery.NewDocumentFromReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", f.URL, err)
+	}
+
+	return doc, nil
+}
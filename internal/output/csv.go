@@ -0,0 +1,59 @@
+package output
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"os"
+
+	"extract-app/internal/scraper"
+)
+
+var csvHeader = []string{
+	"Name", "Error", "HeadSize", "Length", "Balance", "SwingWeight", "BeamWidth",
+	"TipOrShaft", "Composition", "PowerLevel", "Stiffness", "StringPattern",
+	"MainSkip", "StringTension",
+}
+
+// CSVSink writes each RacquetSpecs as a row of a CSV file, flushing after
+// every write so a crash mid-crawl doesn't lose already-scraped rows.
+type CSVSink struct {
+	file   *os.File
+	writer *csv.Writer
+}
+
+// NewCSVSink creates (or truncates) path and writes the header row.
+func NewCSVSink(path string) (*CSVSink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating csv output %s: %w", path, err)
+	}
+
+	w := csv.NewWriter(f)
+	if err := w.Write(csvHeader); err != nil {
+		f.Close()
+		return nil, fmt.Errorf("writing csv header: %w", err)
+	}
+	w.Flush()
+
+	return &CSVSink{file: f, writer: w}, nil
+}
+
+func (s *CSVSink) Write(ctx context.Context, specs scraper.RacquetSpecs) error {
+	row := []string{
+		specs.Name, specs.Error, specs.HeadSize, specs.Length, specs.Balance,
+		specs.SwingWeight, specs.BeamWidth, specs.TipOrShaft, specs.Composition,
+		specs.PowerLevel, specs.Stiffness, specs.StringPattern, specs.MainSkip,
+		specs.StringTension,
+	}
+	if err := s.writer.Write(row); err != nil {
+		return err
+	}
+	s.writer.Flush()
+	return s.writer.Error()
+}
+
+func (s *CSVSink) Close() error {
+	s.writer.Flush()
+	return s.file.Close()
+}
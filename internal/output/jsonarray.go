@@ -0,0 +1,55 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"extract-app/internal/scraper"
+)
+
+// JSONArraySink writes every RacquetSpecs as one element of a single top-level
+// JSON array. Unlike the other sinks this one can't be read until Close has
+// run, since the array isn't valid JSON until its closing bracket is written.
+type JSONArraySink struct {
+	file  *os.File
+	count int
+}
+
+func NewJSONArraySink(path string) (*JSONArraySink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating json output %s: %w", path, err)
+	}
+	if _, err := f.WriteString("[\n"); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &JSONArraySink{file: f}, nil
+}
+
+func (s *JSONArraySink) Write(ctx context.Context, specs scraper.RacquetSpecs) error {
+	data, err := json.Marshal(specs)
+	if err != nil {
+		return err
+	}
+
+	if s.count > 0 {
+		if _, err := s.file.WriteString(",\n"); err != nil {
+			return err
+		}
+	}
+	s.count++
+
+	_, err = s.file.Write(data)
+	return err
+}
+
+func (s *JSONArraySink) Close() error {
+	if _, err := s.file.WriteString("\n]\n"); err != nil {
+		s.file.Close()
+		return err
+	}
+	return s.file.Close()
+}
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"extract-app/internal/scraper"
+)
+
+// JSONLSink writes each RacquetSpecs as its own line of JSON, so a partial
+// run is still readable line-by-line even if the process dies mid-crawl.
+type JSONLSink struct {
+	file    *os.File
+	encoder *json.Encoder
+}
+
+func NewJSONLSink(path string) (*JSONLSink, error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("creating jsonl output %s: %w", path, err)
+	}
+	return &JSONLSink{file: f, encoder: json.NewEncoder(f)}, nil
+}
+
+func (s *JSONLSink) Write(ctx context.Context, specs scraper.RacquetSpecs) error {
+	return s.encoder.Encode(specs)
+}
+
+func (s *JSONLSink) Close() error {
+	return s.file.Close()
+}
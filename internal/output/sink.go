@@ -0,0 +1,27 @@
+package output
+
+import (
+	"fmt"
+
+	"extract-app/internal/scraper"
+)
+
+// New builds the Sink named by format, writing to path. Supported formats are
+// "csv", "json" (a single JSON array), "jsonl" (newline-delimited JSON), and
+// "sqlite". An empty format disables output entirely.
+func New(format, path string) (scraper.Sink, error) {
+	switch format {
+	case "", "none":
+		return nil, nil
+	case "csv":
+		return NewCSVSink(path)
+	case "json":
+		return NewJSONArraySink(path)
+	case "jsonl":
+		return NewJSONLSink(path)
+	case "sqlite":
+		return NewSQLiteSink(path)
+	default:
+		return nil, fmt.Errorf("unknown output format %q", format)
+	}
+}
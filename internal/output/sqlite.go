@@ -0,0 +1,69 @@
+package output
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"extract-app/internal/scraper"
+
+	_ "modernc.org/sqlite" // cgo-free sqlite driver
+)
+
+const createTableSQL = `
+CREATE TABLE IF NOT EXISTS racquets (
+	name           TEXT,
+	error          TEXT,
+	head_size      TEXT,
+	length         TEXT,
+	balance        TEXT,
+	swing_weight   TEXT,
+	beam_width     TEXT,
+	tip_or_shaft   TEXT,
+	composition    TEXT,
+	power_level    TEXT,
+	stiffness      TEXT,
+	string_pattern TEXT,
+	main_skip      TEXT,
+	string_tension TEXT
+)`
+
+const insertSQL = `
+INSERT INTO racquets (
+	name, error, head_size, length, balance, swing_weight, beam_width,
+	tip_or_shaft, composition, power_level, stiffness, string_pattern,
+	main_skip, string_tension
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`
+
+// SQLiteSink appends each RacquetSpecs as a row in a "racquets" table.
+type SQLiteSink struct {
+	db *sql.DB
+}
+
+func NewSQLiteSink(path string) (*SQLiteSink, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("opening sqlite output %s: %w", path, err)
+	}
+
+	if _, err := db.Exec(createTableSQL); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("creating racquets table: %w", err)
+	}
+
+	return &SQLiteSink{db: db}, nil
+}
+
+func (s *SQLiteSink) Write(ctx context.Context, specs scraper.RacquetSpecs) error {
+	_, err := s.db.ExecContext(ctx, insertSQL,
+		specs.Name, specs.Error, specs.HeadSize, specs.Length, specs.Balance,
+		specs.SwingWeight, specs.BeamWidth, specs.TipOrShaft, specs.Composition,
+		specs.PowerLevel, specs.Stiffness, specs.StringPattern, specs.MainSkip,
+		specs.StringTension,
+	)
+	return err
+}
+
+func (s *SQLiteSink) Close() error {
+	return s.db.Close()
+}
@@ -0,0 +1,56 @@
+package scraper
+
+func init() {
+	RegisterAdapter("tenniswarehouse", Job{
+		Name: "tenniswarehouse",
+		// Same results page the SelectSteps drive a browser tab through, but
+		// fetchable directly with the option text as a query param.
+		ResultURLTemplate: "https://www.tennis-warehouse.com/search_results.html?keyword=%s",
+		PreActions: []Step{
+			{Type: "uncheck", Selector: "#currentcheckbox"},
+		},
+		ListExpander: ListExpander{
+			Trigger:      ".drop_arrow",
+			ItemSelector: ".optionslist li",
+		},
+		SelectSteps: []Step{
+			{Type: "click", Selector: `//li[contains(text(), "%s")]`},
+			{Type: "click", Selector: "#search_button"},
+		},
+		RowSelector: ".rac_info",
+		NameField:   "name",
+		Fields: map[string]Extractor{
+			"name":          {Selector: ".rac_name"},
+			"headSize":      {Selector: `.//tr[starts-with(normalize-space(.),"Head Size:")]/td`},
+			"length":        {Selector: `.//tr[starts-with(normalize-space(.),"Length:")]/td`},
+			"balance":       {Selector: `.//tr[starts-with(normalize-space(.),"Balance:")]/td`},
+			"swingWeight":   {Selector: `.//tr[starts-with(normalize-space(.),"Swing Weight:")]/td`},
+			"beamWidth":     {Selector: `.//tr[starts-with(normalize-space(.),"Beam Width:")]/td`},
+			"tipOrShaft":    {Selector: `.//tr[starts-with(normalize-space(.),"Tip/Shaft:")]/td`},
+			"composition":   {Selector: `.//tr[starts-with(normalize-space(.),"Composition:")]/td`},
+			"powerLevel":    {Selector: `.//tr[starts-with(normalize-space(.),"Power Level:")]/td`},
+			"stiffness":     {Selector: `.//tr[starts-with(normalize-space(.),"Stiffness:")]/td`},
+			"stringPattern": {Selector: `.//tr[starts-with(normalize-space(.),"String Pattern:")]/td`},
+			"mainSkip":      {Selector: `.//tr[starts-with(normalize-space(.),"Main Skip:")]/td`},
+			"stringTension": {Selector: `.//tr[starts-with(normalize-space(.),"String Tension:")]/td`},
+		},
+		// CSS overrides for the HTTP fast path: the spec table's rows carry a
+		// per-field class matching these keys alongside the label cells the
+		// XPath extractors above locate by text, e.g.
+		// <tr class="headSize"><td>Head Size:</td><td>16x19</td></tr>.
+		HTTPFields: map[string]Extractor{
+			"headSize":      {Selector: "tr.headSize td:last-child"},
+			"length":        {Selector: "tr.length td:last-child"},
+			"balance":       {Selector: "tr.balance td:last-child"},
+			"swingWeight":   {Selector: "tr.swingWeight td:last-child"},
+			"beamWidth":     {Selector: "tr.beamWidth td:last-child"},
+			"tipOrShaft":    {Selector: "tr.tipOrShaft td:last-child"},
+			"composition":   {Selector: "tr.composition td:last-child"},
+			"powerLevel":    {Selector: "tr.powerLevel td:last-child"},
+			"stiffness":     {Selector: "tr.stiffness td:last-child"},
+			"stringPattern": {Selector: "tr.stringPattern td:last-child"},
+			"mainSkip":      {Selector: "tr.mainSkip td:last-child"},
+			"stringTension": {Selector: "tr.stringTension td:last-child"},
+		},
+	})
+}
@@ -0,0 +1,72 @@
+package scraper
+
+// Step describes a single action taken against the page: revealing a dropdown,
+// unchecking a filter box, filling in a field, or clicking an element. Selector
+// may contain a single "%s" placeholder that gets filled in with the current
+// option text at run time (see SelectSteps on Job).
+type Step struct {
+	Type     string // "click", "uncheck", or "fill"
+	Selector string
+	Value    string // used by "fill"
+}
+
+// ListExpander describes how to reveal the list of selectable options for a job,
+// e.g. clicking a dropdown arrow and reading the resulting <li> items.
+type ListExpander struct {
+	Trigger      string // selector clicked to reveal the option list
+	ItemSelector string // selector matching each option item once revealed
+}
+
+// Extractor describes how to pull one field out of a result row. Selector is a
+// CSS selector evaluated relative to the row, or an XPath expression (detected by
+// a leading ".//" or "//") for label-driven lookups such as spec tables. Regex,
+// if set, is applied to the extracted text and the first submatch is kept.
+type Extractor struct {
+	Selector string
+	Attr     string // optional attribute to read instead of text content
+	Regex    string
+}
+
+// Job is a declarative description of a scrape target: where to navigate, how to
+// expand and walk its option list, and how to pull fields out of each result row.
+// It exists so new vendors can be supported by registering a Job instead of
+// writing Go code against chromedp directly.
+type Job struct {
+	Name         string
+	BaseURL      string
+	PreActions   []Step
+	ListExpander ListExpander
+	SelectSteps  []Step // run per option to choose it (via "%s") and submit, before scraping results
+	RowSelector  string // selector that roots a single result row (one per item)
+	NameField    string // key into Fields identifying the row's display name
+	Fields       map[string]Extractor
+
+	// ResultURLTemplate, if set, is a URL with a single "%s" for the
+	// (URL-escaped) option text that fetches the results page with a plain
+	// GET instead of driving a browser tab through SelectSteps. Leave empty
+	// for targets whose results only appear after a JS-driven form
+	// submission.
+	ResultURLTemplate string
+
+	// HTTPFields holds CSS-only overrides of Fields for use over the HTTP
+	// fast path. A field missing here falls back to Fields, but only if that
+	// entry's Selector is CSS (XPath extractors in Fields are never usable
+	// against a goquery document). Targets whose spec tables are keyed by
+	// label text rather than a per-field class, like tenniswarehouse, need a
+	// full override set since every Fields extractor there is XPath.
+	HTTPFields map[string]Extractor
+}
+
+var adapters = map[string]Job{}
+
+// RegisterAdapter makes a Job available under name for Config.Adapter to select.
+// Call it from an init() func, following the tenniswarehouse adapter's lead.
+func RegisterAdapter(name string, job Job) {
+	adapters[name] = job
+}
+
+// GetAdapter looks up a registered Job by name.
+func GetAdapter(name string) (Job, bool) {
+	job, ok := adapters[name]
+	return job, ok
+}
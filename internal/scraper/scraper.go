@@ -6,10 +6,21 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
 	"time"
 
+	"extract-app/internal/browser"
+	"extract-app/internal/checkpoint"
 	"extract-app/internal/config"
+	"extract-app/internal/fetcher"
 
+	"github.com/PuerkitoBio/goquery"
+	"github.com/chromedp/cdproto/network"
 	"github.com/chromedp/chromedp"
 )
 
@@ -21,8 +32,28 @@ type Result struct {
 
 type Scraper struct {
 	ctx           context.Context
+	cfg           *config.Config
 	actionTimeout time.Duration
 	debug         bool
+	job           Job
+	url           string
+	concurrency   int
+	throttle      *Throttle
+	sink          Sink
+	maxRetries    int
+	backoffBase   time.Duration
+	userAgent     string
+	preferHTTP    bool
+
+	checkpointer checkpoint.Checkpointer
+	resume       bool
+	checkpointMu sync.Mutex
+	checkpoint   *checkpoint.State
+
+	// rootRunMu serializes chromedp.Run calls made directly against the root
+	// browser context from concurrent workers (e.g. exportCookies), since a
+	// single CDP target's connection isn't safe for overlapping Run calls.
+	rootRunMu sync.Mutex
 }
 
 type RacquetSpecs struct {
@@ -59,72 +90,173 @@ func (r *RacquetSpecs) Print() {
 	fmt.Printf("String Tension: %s\n", r.StringTension)
 }
 
-// fmt.Printf is a helper function to print a field with a label
-// func fmt.Printf(label string, value *string) {
-// 	if value != nil {
-// 		fmt.Printf("%s: %s\n", label, *value) // Dereference safely
-// 	} else {
-// 		fmt.Printf("%s: not set\n", label) // Handle the nil case
-// 	}
-// }
+// specsFromFields maps the generic field extraction produced by a Job into the
+// concrete RacquetSpecs schema. Fields absent from the map are left blank.
+func specsFromFields(fields map[string]string) RacquetSpecs {
+	return RacquetSpecs{
+		Name:          fields["name"],
+		Error:         fields["error"],
+		HeadSize:      fields["headSize"],
+		Length:        fields["length"],
+		Balance:       fields["balance"],
+		SwingWeight:   fields["swingWeight"],
+		BeamWidth:     fields["beamWidth"],
+		TipOrShaft:    fields["tipOrShaft"],
+		Composition:   fields["composition"],
+		PowerLevel:    fields["powerLevel"],
+		Stiffness:     fields["stiffness"],
+		StringPattern: fields["stringPattern"],
+		MainSkip:      fields["mainSkip"],
+		StringTension: fields["stringTension"],
+	}
+}
 
+// New builds a Scraper bound to the adapter named by cfg.Adapter, falling back to
+// the tenniswarehouse adapter so existing callers keep working unmodified.
 func New(ctx context.Context, cfg *config.Config) *Scraper {
+	adapterName := cfg.Adapter
+	if adapterName == "" {
+		adapterName = "tenniswarehouse"
+	}
+
+	job, ok := GetAdapter(adapterName)
+	if !ok {
+		log.Printf("Unknown adapter %q, falling back to tenniswarehouse", adapterName)
+		job, _ = GetAdapter("tenniswarehouse")
+	}
+
+	concurrency := cfg.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var checkpointer checkpoint.Checkpointer
+	if cfg.CheckpointPath != "" {
+		checkpointer = checkpoint.NewFileCheckpointer(cfg.CheckpointPath)
+	}
+
 	return &Scraper{
 		ctx:           ctx,
+		cfg:           cfg,
 		actionTimeout: cfg.ActionTimeout,
 		debug:         cfg.Debug,
+		job:           job,
+		concurrency:   concurrency,
+		throttle:      NewThrottle(cfg.ThrottleNum, cfg.ThrottleWindow),
+		sink:          nopSink{},
+		maxRetries:    cfg.MaxRetries,
+		backoffBase:   cfg.BackoffBase,
+		userAgent:     cfg.UserAgent,
+		preferHTTP:    cfg.PreferHTTP,
+		checkpointer:  checkpointer,
+		resume:        cfg.Resume,
 	}
 }
 
-// runWithTimeout runs an action with a specific timeout
+// SetSink configures where scraped RacquetSpecs are streamed as they're found.
+// Without a call to SetSink, results are discarded once printed.
+func (s *Scraper) SetSink(sink Sink) {
+	if sink == nil {
+		sink = nopSink{}
+	}
+	s.sink = sink
+}
+
+// runWithTimeout runs an action with a specific timeout, retrying with
+// exponential backoff and jitter (capped at s.maxRetries) on a timed-out or
+// otherwise transient chromedp error. Parent context cancellation is never
+// retried.
 func (s *Scraper) runWithTimeout(actions ...chromedp.Action) error {
+	var lastErr error
+
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		err := s.runOnce(actions...)
+		if err == nil {
+			return nil
+		}
+
+		lastErr = s.wrapRunError(err)
+		if errors.Is(err, context.Canceled) || attempt == s.maxRetries {
+			return lastErr
+		}
+
+		wait := backoffWithJitter(s.backoffBase, attempt)
+		log.Printf("Retrying action (attempt %d/%d) after %v: %v", attempt+1, s.maxRetries, wait, lastErr)
+
+		select {
+		case <-s.ctx.Done():
+			return fmt.Errorf("parent context canceled during retry backoff: %w", s.ctx.Err())
+		case <-time.After(wait):
+		}
+	}
+
+	return lastErr
+}
+
+// runOnce runs actions exactly once under s.actionTimeout, without retrying.
+func (s *Scraper) runOnce(actions ...chromedp.Action) error {
 	select {
 	case <-s.ctx.Done():
 		return fmt.Errorf("parent context canceled: %w", s.ctx.Err())
 	default:
 		timeoutCtx, cancel := context.WithTimeout(s.ctx, s.actionTimeout)
 		defer cancel()
+		return chromedp.Run(timeoutCtx, actions...)
+	}
+}
 
-		err := chromedp.Run(timeoutCtx, actions...)
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				return fmt.Errorf("action context canceled during execution")
-			}
-			if errors.Is(err, context.DeadlineExceeded) {
-				return fmt.Errorf("action timed out after %v", s.actionTimeout)
-			}
-			return err
-		}
-		return nil
+// wrapRunError turns a raw chromedp/context error into the same messages
+// runWithTimeout always returned, even when retries are exhausted.
+func (s *Scraper) wrapRunError(err error) error {
+	if errors.Is(err, context.Canceled) {
+		return fmt.Errorf("action context canceled during execution")
 	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return fmt.Errorf("action timed out after %v", s.actionTimeout)
+	}
+	return err
 }
 
-// GetOptions handles initial setup and gets the list of options
-func (s *Scraper) GetOptions(url string) ([]RacquetSpecs, error) {
-	// var results []Result
+// backoffWithJitter returns base*2^attempt plus up to half that much jitter.
+func backoffWithJitter(base time.Duration, attempt int) time.Duration {
+	if base <= 0 {
+		return 0
+	}
+	backoff := base * time.Duration(1<<uint(attempt))
+	jitter := time.Duration(rand.Int63n(int64(backoff)/2 + 1))
+	return backoff + jitter
+}
 
+// GetOptions handles initial setup, walks the option list, and streams every
+// scraped RacquetSpecs to the configured Sink. It returns the count of
+// racquets written rather than the racquets themselves, since a full crawl
+// can produce thousands and the whole point of a Sink is to not hold them all
+// in memory at once.
+func (s *Scraper) GetOptions(url string) (int, error) {
 	// Initial context check
 	if err := s.ctx.Err(); err != nil {
-		return nil, fmt.Errorf("initial context error: %w", err)
+		return 0, fmt.Errorf("initial context error: %w", err)
 	}
 
+	s.url = url
+
 	// Navigate and setup
 	if err := s.setupPage(url); err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Get options
 	var options []string
 	if err := s.runWithTimeout(
-		chromedp.Click(`.drop_arrow`, chromedp.ByQuery),
+		chromedp.Click(s.job.ListExpander.Trigger, chromedp.ByQuery),
 		chromedp.Sleep(1*time.Second),
-		chromedp.Evaluate(`
-			Array.from(document.querySelectorAll('.optionslist li'))
+		chromedp.Evaluate(fmt.Sprintf(`
+			Array.from(document.querySelectorAll('%s'))
 				.map(el => el.textContent.trim())
 				.filter(text => text !== '' && text !== 'Select')
-		`, &options),
+		`, s.job.ListExpander.ItemSelector), &options),
 	); err != nil {
-		return nil, fmt.Errorf("options error: %w", err)
+		return 0, fmt.Errorf("options error: %w", err)
 	}
 
 	// Log the filtered options
@@ -133,8 +265,99 @@ func (s *Scraper) GetOptions(url string) ([]RacquetSpecs, error) {
 		log.Printf("Option %d: %s", i+1, opt)
 	}
 
+	s.loadCheckpoint(url, options)
+
 	// Process each option
-	return s.processOptions(options)
+	count, err := s.processOptions(options)
+	if closeErr := s.sink.Close(); closeErr != nil {
+		log.Printf("Error closing sink: %v", closeErr)
+	}
+	return count, err
+}
+
+// loadCheckpoint restores a prior checkpoint for url when --resume is set and
+// one matches, otherwise starts a fresh checkpoint state. It is a no-op when
+// no Checkpointer is configured.
+func (s *Scraper) loadCheckpoint(url string, options []string) {
+	if s.checkpointer == nil {
+		return
+	}
+
+	if s.resume {
+		if state, ok, err := s.checkpointer.Load(url); err != nil {
+			log.Printf("Error loading checkpoint: %v", err)
+		} else if ok && optionsMatch(state.Options, options) {
+			log.Printf("Resuming crawl: %d/%d options already completed", len(state.Completed), len(options))
+			s.checkpoint = state
+		} else if ok {
+			log.Printf("Checkpoint's option list no longer matches this run's (was %d, now %d); starting fresh to avoid resuming against the wrong indices", len(state.Options), len(options))
+		}
+	}
+
+	if s.checkpoint == nil {
+		s.checkpoint = checkpoint.NewState(url, options)
+	}
+
+	if err := s.checkpointer.Save(s.checkpoint); err != nil {
+		log.Printf("Error saving initial checkpoint: %v", err)
+	}
+}
+
+// optionsMatch reports whether a checkpointed option list still lines up
+// index-for-index with the option list just scraped, so Completed/resume
+// indices from the checkpoint can be trusted. If the site's dropdown added,
+// removed, or reordered an option between runs, the indices would otherwise
+// silently point at the wrong option.
+func optionsMatch(checkpointed, current []string) bool {
+	if len(checkpointed) != len(current) {
+		return false
+	}
+	for i, opt := range checkpointed {
+		if opt != current[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// isOptionDone reports whether option index i was already completed in a
+// prior run being resumed.
+func (s *Scraper) isOptionDone(i int) bool {
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+	return s.checkpoint != nil && s.checkpoint.Completed[i]
+}
+
+// markOptionDone records that option index i has fully finished and persists
+// the checkpoint.
+func (s *Scraper) markOptionDone(i int) {
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+	if s.checkpoint == nil {
+		return
+	}
+	s.checkpoint.Completed[i] = true
+	if err := s.checkpointer.Save(s.checkpoint); err != nil {
+		log.Printf("Error saving checkpoint: %v", err)
+	}
+}
+
+// alreadyEmitted reports whether name has already been written to the sink in
+// this or a prior (resumed) run, recording it as emitted if not.
+func (s *Scraper) alreadyEmitted(name string) bool {
+	if s.checkpoint == nil {
+		return false
+	}
+
+	hash := checkpoint.HashName(name)
+
+	s.checkpointMu.Lock()
+	defer s.checkpointMu.Unlock()
+	if s.checkpoint.EmittedHashes[hash] {
+		return true
+	}
+	s.checkpoint.EmittedHashes[hash] = true
+	return false
 }
 
 // setupPage handles navigation and initial page setup
@@ -172,58 +395,291 @@ func (s *Scraper) setupPage(url string) error {
 		return fmt.Errorf("page load verification failed: %w", err)
 	}
 
-	// Uncheck the checkbox
-	log.Println("Unchecking current checkbox...")
-	if err := s.runWithTimeout(
-		chromedp.WaitVisible(`#currentcheckbox`, chromedp.ByID),
-		chromedp.Evaluate(`
-			const checkbox = document.getElementById('currentcheckbox');
-			if (checkbox.checked) {
-				checkbox.click();
+	// Run the job's pre-actions (e.g. unchecking a "current racquets only" filter)
+	for _, step := range s.job.PreActions {
+		if err := s.runStep(step, ""); err != nil {
+			return fmt.Errorf("pre-action %q on %q failed: %w", step.Type, step.Selector, err)
+		}
+	}
+
+	return nil
+}
+
+// runStep executes a single declarative Step, substituting option into any "%s"
+// placeholder in its Selector.
+func (s *Scraper) runStep(step Step, option string) error {
+	selector := step.Selector
+	if option != "" && strings.Contains(selector, "%s") {
+		selector = fmt.Sprintf(selector, option)
+	}
+
+	switch step.Type {
+	case "click":
+		by := chromedp.ByQuery
+		if strings.HasPrefix(selector, "//") || strings.HasPrefix(selector, ".//") {
+			by = chromedp.BySearch
+		}
+		return s.runWithTimeout(
+			chromedp.Click(selector, by),
+			chromedp.Sleep(1*time.Second),
+		)
+	case "uncheck":
+		return s.runWithTimeout(
+			chromedp.WaitVisible(selector, chromedp.ByQuery),
+			chromedp.Evaluate(fmt.Sprintf(`
+				(() => {
+					const el = document.querySelector('%s');
+					if (el && el.checked) el.click();
+				})()
+			`, selector), nil),
+			chromedp.Sleep(1*time.Second),
+		)
+	case "fill":
+		return s.runWithTimeout(
+			chromedp.WaitVisible(selector, chromedp.ByQuery),
+			chromedp.SetValue(selector, step.Value, chromedp.ByQuery),
+		)
+	default:
+		return fmt.Errorf("unknown step type %q", step.Type)
+	}
+}
+
+// processOptions dispatches to the serial or concurrent worker-pool path
+// depending on s.concurrency, and returns the count of racquets streamed to
+// the sink.
+func (s *Scraper) processOptions(options []string) (int, error) {
+	if s.concurrency > 1 {
+		return s.processOptionsConcurrent(options)
+	}
+	return s.processOptionsSerial(options)
+}
+
+// optionResult is one worker's output from processOptionsConcurrent, tagged
+// with its original option index so results can be merged back into order.
+type optionResult struct {
+	index int
+	specs []RacquetSpecs
+	err   error
+}
+
+// processOptionsConcurrent processes options in parallel, each in its own
+// chromedp child target (tab), throttled by s.throttle. Workers stream their
+// specs through a results channel rather than writing to the sink directly;
+// this goroutine merges them back into option order before writing, so
+// output order matches the serial path even though tabs finish out of order.
+func (s *Scraper) processOptionsConcurrent(options []string) (int, error) {
+	sem := make(chan struct{}, s.concurrency)
+	results := make(chan optionResult, len(options))
+	var wg sync.WaitGroup
+
+	var dispatched []int
+	for i, option := range options {
+		if s.isOptionDone(i) {
+			log.Printf("Skipping already-completed option %d/%d: %s", i+1, len(options), option)
+			continue
+		}
+		dispatched = append(dispatched, i)
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, option string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			if err := s.throttle.Wait(s.ctx); err != nil {
+				log.Printf("Throttle wait canceled for option %s: %v", option, err)
+				results <- optionResult{index: i, err: err}
+				return
 			}
-		`, nil),
+
+			log.Printf("Processing option %d/%d in a worker tab: %s", i+1, len(options), option)
+			specs, err := s.processOptionInTab(option)
+			if err != nil {
+				log.Printf("Error processing option %s in worker tab: %v", option, err)
+			}
+			results <- optionResult{index: i, specs: specs, err: err}
+		}(i, option)
+	}
+
+	go func() {
+		wg.Wait()
+		close(results)
+	}()
+
+	// pos walks dispatched in order; buffered holds results that arrived
+	// ahead of the option still being waited on.
+	pos := 0
+	buffered := make(map[int]optionResult)
+	total := 0
+
+	drainReady := func() {
+		for pos < len(dispatched) {
+			i := dispatched[pos]
+			r, ok := buffered[i]
+			if !ok {
+				return
+			}
+			delete(buffered, i)
+
+			for _, spec := range r.specs {
+				spec.Print()
+				if s.alreadyEmitted(spec.Name) {
+					continue
+				}
+				if err := s.sink.Write(s.ctx, spec); err != nil {
+					log.Printf("Error writing spec for %s to sink: %v", spec.Name, err)
+					continue
+				}
+				total++
+			}
+
+			if r.err == nil {
+				s.markOptionDone(i)
+			}
+			pos++
+		}
+	}
+
+	for r := range results {
+		buffered[r.index] = r
+		drainReady()
+	}
+
+	return total, nil
+}
+
+// processOptionInTab runs the full per-option pipeline (navigate, pre-actions,
+// expand the list, select the option, scrape results) in a fresh tab so
+// concurrent workers don't fight over a single page's DOM.
+func (s *Scraper) processOptionInTab(option string) ([]RacquetSpecs, error) {
+	if specs, ok, err := s.tryHTTPFetch(option); err != nil {
+		log.Printf("HTTP fast path failed for option %s, falling back to chromedp: %v", option, err)
+	} else if ok {
+		return specs, nil
+	}
+
+	tabCtx, cancel := chromedp.NewContext(s.ctx)
+	defer cancel()
+
+	// chromedp.ListenTarget and the stealth/cookie startup actions browser.NewChrome
+	// wired up only cover the initial tab's target, so each worker tab needs them
+	// re-applied against its own target here.
+	if s.cfg != nil {
+		if err := browser.ApplyTargetSetup(tabCtx, s.cfg); err != nil {
+			log.Printf("Failed to apply target setup to worker tab: %v", err)
+		}
+	}
+
+	tab := &Scraper{
+		ctx:           tabCtx,
+		actionTimeout: s.actionTimeout,
+		debug:         s.debug,
+		job:           s.job,
+		maxRetries:    s.maxRetries,
+		backoffBase:   s.backoffBase,
+	}
+
+	if err := tab.runWithTimeout(
+		chromedp.Navigate(s.url),
+		chromedp.Sleep(5*time.Second),
+		chromedp.WaitReady("body", chromedp.ByQuery),
+	); err != nil {
+		return nil, fmt.Errorf("navigating worker tab: %w", err)
+	}
+
+	for _, step := range tab.job.PreActions {
+		if err := tab.runStep(step, ""); err != nil {
+			return nil, fmt.Errorf("pre-action in worker tab: %w", err)
+		}
+	}
+
+	if err := tab.runWithTimeout(
+		chromedp.Click(tab.job.ListExpander.Trigger, chromedp.ByQuery),
 		chromedp.Sleep(1*time.Second),
 	); err != nil {
-		return fmt.Errorf("failed to uncheck checkbox: %w", err)
+		return nil, fmt.Errorf("opening dropdown in worker tab: %w", err)
 	}
 
-	return nil
+	for _, step := range tab.job.SelectSteps {
+		if err := tab.runStep(step, option); err != nil {
+			return nil, fmt.Errorf("select step in worker tab: %w", err)
+		}
+	}
+
+	nameSelector := tab.job.RowSelector + " " + tab.job.Fields[tab.job.NameField].Selector
+	var racNames []string
+	if err := tab.runWithTimeout(
+		chromedp.Evaluate(fmt.Sprintf(`
+			Array.from(document.querySelectorAll('%s'))
+				.map(el => el.textContent.trim())
+		`, nameSelector), &racNames),
+	); err != nil {
+		return nil, fmt.Errorf("getting results in worker tab: %w", err)
+	}
+
+	return tab.getRacquetInfo(racNames)
 }
 
-// processOptions handles processing each individual option
-func (s *Scraper) processOptions(options []string) ([]RacquetSpecs, error) {
-	var results []RacquetSpecs
+// processOptionsSerial is the original single-tab walk, used when
+// s.concurrency is 1. It streams each spec to the sink as soon as it's
+// scraped instead of buffering the whole run in memory.
+func (s *Scraper) processOptionsSerial(options []string) (int, error) {
+	total := 0
 
 	for i, option := range options {
-		specs := []RacquetSpecs{}
+		if s.isOptionDone(i) {
+			log.Printf("Skipping already-completed option %d/%d: %s", i+1, len(options), option)
+			continue
+		}
+
 		log.Printf("Processing option %d/%d: %s", i+1, len(options), option)
 
-		// result := Result{Option: option}
+		if specs, ok, err := s.tryHTTPFetch(option); err != nil {
+			log.Printf("HTTP fast path failed for option %s, falling back to chromedp: %v", option, err)
+		} else if ok {
+			for _, spec := range specs {
+				spec.Print()
+				if s.alreadyEmitted(spec.Name) {
+					continue
+				}
+				if err := s.sink.Write(s.ctx, spec); err != nil {
+					log.Printf("Error writing spec for %s to sink: %v", spec.Name, err)
+					continue
+				}
+				total++
+			}
+			s.markOptionDone(i)
+			continue
+		}
 
-		// Click option and search
-		if err := s.runWithTimeout(
-			chromedp.Click(fmt.Sprintf(`//li[contains(text(), "%s")]`, option), chromedp.BySearch),
-			chromedp.Sleep(1*time.Second),
-			chromedp.Click(`#search_button`, chromedp.ByID),
-			chromedp.Sleep(2*time.Second),
-		); err != nil {
-			log.Printf("Error processing option %s: %v", option, err)
+		// Run the job's select steps (choose the option, submit the search, etc.)
+		stepsOK := true
+		for _, step := range s.job.SelectSteps {
+			if err := s.runStep(step, option); err != nil {
+				log.Printf("Error processing option %s: %v", option, err)
+				stepsOK = false
+				break
+			}
+		}
+		if !stepsOK {
 			continue
 		}
 
-		// Get results
+		// Get result row names
+		nameSelector := s.job.RowSelector + " " + s.job.Fields[s.job.NameField].Selector
 		var racNames []string
 		if err := s.runWithTimeout(
-			chromedp.Evaluate(`
-				Array.from(document.querySelectorAll('.rac_info .rac_name'))
+			chromedp.Evaluate(fmt.Sprintf(`
+				Array.from(document.querySelectorAll('%s'))
 					.map(el => el.textContent.trim())
-			`, &racNames),
+			`, nameSelector), &racNames),
 		); err != nil {
 			log.Printf("Error getting results for option %s: %v", option, err)
 			continue
 		}
 
-		// Print RAC names as we find them
+		// Print and stream RAC names as we find them
 		if len(racNames) > 0 {
 			log.Printf("Found %d RAC names for option '%s':", len(racNames), option)
 			for j, name := range racNames {
@@ -232,6 +688,14 @@ func (s *Scraper) processOptions(options []string) ([]RacquetSpecs, error) {
 			specs, err := s.getRacquetInfo(racNames)
 			for _, spec := range specs {
 				spec.Print()
+				if s.alreadyEmitted(spec.Name) {
+					continue
+				}
+				if err := s.sink.Write(s.ctx, spec); err != nil {
+					log.Printf("Error writing spec for %s to sink: %v", spec.Name, err)
+					continue
+				}
+				total++
 			}
 			if err != nil {
 				log.Printf("Error getting specs for racquets: %v", err)
@@ -241,12 +705,11 @@ func (s *Scraper) processOptions(options []string) ([]RacquetSpecs, error) {
 			log.Printf("No RAC names found for option '%s'", option)
 		}
 
-		// result.RacNames = racNames
-		results = append(results, specs...)
+		s.markOptionDone(i)
 
 		// Click dropdown for next option
 		if err := s.runWithTimeout(
-			chromedp.Click(`.drop_arrow`, chromedp.ByQuery),
+			chromedp.Click(s.job.ListExpander.Trigger, chromedp.ByQuery),
 			chromedp.Sleep(1*time.Second),
 		); err != nil {
 			log.Printf("Error reopening dropdown: %v", err)
@@ -254,92 +717,209 @@ func (s *Scraper) processOptions(options []string) ([]RacquetSpecs, error) {
 		}
 	}
 
-	return results, nil
+	return total, nil
+}
+
+// tryHTTPFetch attempts the HTTP+goquery fast path for option: if the job
+// doesn't support it, or the fetch comes back without the expected rows, it
+// returns ok=false so the caller falls back to driving chromedp.
+func (s *Scraper) tryHTTPFetch(option string) (specs []RacquetSpecs, ok bool, err error) {
+	if !s.preferHTTP || s.job.ResultURLTemplate == "" {
+		return nil, false, nil
+	}
+
+	cookies, err := s.exportCookies()
+	if err != nil {
+		return nil, false, fmt.Errorf("exporting cookies for HTTP fast path: %w", err)
+	}
+
+	userAgent := s.userAgent
+	if userAgent == "" {
+		s.rootRunMu.Lock()
+		err = chromedp.Run(s.ctx, chromedp.Evaluate(`navigator.userAgent`, &userAgent))
+		s.rootRunMu.Unlock()
+		if err != nil {
+			return nil, false, fmt.Errorf("reading browser user agent for HTTP fast path: %w", err)
+		}
+	}
+
+	f := &fetcher.HTTPFetcher{
+		URL:       fmt.Sprintf(s.job.ResultURLTemplate, url.QueryEscape(option)),
+		UserAgent: userAgent,
+		Cookies:   cookies,
+	}
+
+	doc, err := f.Fetch(s.ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rows := doc.Find(s.job.RowSelector)
+	if rows.Length() == 0 {
+		return nil, false, nil
+	}
+
+	rows.Each(func(_ int, row *goquery.Selection) {
+		specs = append(specs, specsFromFields(extractFieldsFromDoc(row, s.job)))
+	})
+
+	return specs, true, nil
+}
+
+// exportCookies reads the live browser session's cookies so the HTTP fast
+// path can fetch results as the same logical session. It runs against the
+// shared root context (not a per-worker tab), so concurrent callers are
+// serialized through rootRunMu rather than racing chromedp.Run on the same
+// CDP target.
+func (s *Scraper) exportCookies() ([]*http.Cookie, error) {
+	s.rootRunMu.Lock()
+	defer s.rootRunMu.Unlock()
+
+	var cdpCookies []*network.Cookie
+	if err := chromedp.Run(s.ctx, chromedp.ActionFunc(func(ctx context.Context) error {
+		var err error
+		cdpCookies, err = network.GetCookies().Do(ctx)
+		return err
+	})); err != nil {
+		return nil, err
+	}
+
+	cookies := make([]*http.Cookie, 0, len(cdpCookies))
+	for _, c := range cdpCookies {
+		cookies = append(cookies, &http.Cookie{Name: c.Name, Value: c.Value})
+	}
+	return cookies, nil
+}
+
+// applyRegex returns value unchanged unless extractor.Regex is set and
+// matches, in which case the first submatch is kept. Shared by the chromedp
+// and HTTP fast paths so a Regex extractor behaves identically on both.
+func applyRegex(extractor Extractor, value string) string {
+	if extractor.Regex == "" {
+		return value
+	}
+	re, err := regexp.Compile(extractor.Regex)
+	if err != nil {
+		return value
+	}
+	if match := re.FindStringSubmatch(value); len(match) > 1 {
+		return match[1]
+	}
+	return value
+}
+
+// extractFieldsFromDoc runs a Job's CSS extractors against a single result
+// row parsed from plain HTML, mirroring what extractFields does against a
+// live tab, including each extractor's Regex post-processing. A field's
+// HTTPFields entry takes priority when present; failing that, its Fields
+// extractor is used if it's CSS (XPath extractors aren't supported by
+// goquery and are skipped).
+func extractFieldsFromDoc(row *goquery.Selection, job Job) map[string]string {
+	out := map[string]string{"error": ""}
+
+	fieldNames := make(map[string]struct{}, len(job.Fields)+len(job.HTTPFields))
+	for field := range job.Fields {
+		fieldNames[field] = struct{}{}
+	}
+	for field := range job.HTTPFields {
+		fieldNames[field] = struct{}{}
+	}
+
+	for field := range fieldNames {
+		extractor, ok := job.HTTPFields[field]
+		if !ok {
+			extractor = job.Fields[field]
+			if strings.HasPrefix(extractor.Selector, "//") || strings.HasPrefix(extractor.Selector, ".//") {
+				continue
+			}
+		}
+
+		sel := row.Find(extractor.Selector).First()
+		value := strings.TrimSpace(sel.Text())
+		if extractor.Attr != "" {
+			value, _ = sel.Attr(extractor.Attr)
+		}
+		out[field] = applyRegex(extractor, value)
+	}
+
+	return out
 }
 
 func (s *Scraper) getRacquetInfo(racNames []string) ([]RacquetSpecs, error) {
 	var specs []RacquetSpecs
 
 	for _, name := range racNames {
-		// Find the racquet info div
-		var racquetInfo RacquetSpecs
-		var stringifySpecs string
-		// racquetInfo.name = name
-
-		// Get specs using JavaScript evaluation
-		if err := s.runWithTimeout(
-			chromedp.Evaluate(`
-				(() => {
-					// Find the div containing this racquet name
-					// const racDiv = Array.from(document.querySelectorAll('#rac_name'))
-					// 	.find(el => el.textContent.trim() === `+"`"+name+"`"+`);
-					let foundDiv = null;
-					let racNameDivs = document.querySelectorAll('.rac_name');
-					for (let i = 0; i < racNameDivs.length; i++) {
-						let currDiv = racNameDivs[i];
-						if (currDiv.textContent.trim() === `+"`"+name+"`"+`) foundDiv = currDiv;
-					}
-					if (!foundDiv) return {
-						name: `+"`"+name+"`"+`,
-						error: "Couldn't find racDiv"
-					};
-					let parent = foundDiv.parentNode;
-					console.dir(parent);
-					if (!parent) {
-						return {
-							name: `+"`"+name+"`"+`,
-							error: "Couldn't find parent"
-						};
-					}
-
-					// Helper to find spec value
-					const getSpec = (label) => {
-						console.log("label: ", label);
-						let trList = parent.querySelectorAll('tr');
-						for (let i = 0; i < trList.length; i++) {
-							let currTr = trList[i];
-							if (currTr.textContent.trim().startsWith(label)) {
-								console.log(currTr.querySelector('td').textContent.trim());
-								return currTr.querySelector('td').textContent.trim();
-							}
-						}
-						return "";
-					};
-
-					let headSize = getSpec('Head Size:');
-					console.log("headSize: ", headSize);
-
-					return JSON.stringify({
-						name: `+"`"+name+"`"+`,
-						error: '',
-						headSize: headSize,
-						length: getSpec('Length:'),
-						balance: getSpec('Balance:'),
-						swingWeight: getSpec('Swing Weight:'),
-						beamWidth: getSpec('Beam Width:'),
-						tipOrShaft: getSpec('Tip/Shaft:'),
-						composition: getSpec('Composition:'),
-						powerLevel: getSpec('Power Level:'),
-						stiffness: getSpec('Stiffness:'),
-						stringPattern: getSpec('String Pattern:'),
-						mainSkip: getSpec('Main Skip:'),
-						stringTension: getSpec('String Tension:')
-					});
-				})()
-			`, &stringifySpecs),
-		); err != nil {
+		fields, err := s.extractFields(name)
+		if err != nil {
 			log.Printf("Error getting specs for racquet %s: %v", name, err)
 			continue
 		}
+		specs = append(specs, specsFromFields(fields))
+	}
 
-		// Unmarshal JSON string into racquetInfo struct
-		if err := json.Unmarshal([]byte(stringifySpecs), &racquetInfo); err != nil {
-			log.Printf("Error unmarshalling JSON for racquet %s: %v", name, err)
-			continue
-		}
+	return specs, nil
+}
 
-		specs = append(specs, racquetInfo)
+// extractFields runs the job's field extractors against the result row whose
+// name field equals name, returning the raw field-name -> value map. This is
+// the generic engine that every concrete schema (e.g. RacquetSpecs) is built on.
+func (s *Scraper) extractFields(name string) (map[string]string, error) {
+	encodedFields, err := json.Marshal(s.job.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("encoding job fields: %w", err)
 	}
 
-	return specs, nil
+	var stringifiedFields string
+	if err := s.runWithTimeout(
+		chromedp.Evaluate(fmt.Sprintf(`
+			(() => {
+				const rowSelector = %q;
+				const nameField = %q;
+				const fields = %s;
+				const name = %q;
+
+				const rows = document.querySelectorAll(rowSelector);
+				let found = null;
+				for (const row of rows) {
+					const nameSel = fields[nameField].Selector;
+					const el = row.querySelector(nameSel);
+					if (el && el.textContent.trim() === name) { found = row; break; }
+				}
+				if (!found) return JSON.stringify({ name: name, error: "row not found" });
+
+				const out = { name: name, error: "" };
+				for (const [field, extractor] of Object.entries(fields)) {
+					if (field === nameField) continue;
+					let value = "";
+					try {
+						if (extractor.Selector.startsWith("//") || extractor.Selector.startsWith(".//")) {
+							const res = document.evaluate(extractor.Selector, found, null, XPathResult.ANY_TYPE, null);
+							const node = res.iterateNext();
+							value = node ? (extractor.Attr ? node.getAttribute(extractor.Attr) : node.textContent).trim() : "";
+						} else {
+							const node = found.querySelector(extractor.Selector);
+							value = node ? (extractor.Attr ? node.getAttribute(extractor.Attr) : node.textContent).trim() : "";
+						}
+					} catch (e) {
+						value = "";
+					}
+					out[field] = value || "";
+				}
+				return JSON.stringify(out);
+			})()
+		`, s.job.RowSelector, s.job.NameField, string(encodedFields), name), &stringifiedFields),
+	); err != nil {
+		return nil, err
+	}
+
+	var raw map[string]string
+	if err := json.Unmarshal([]byte(stringifiedFields), &raw); err != nil {
+		return nil, fmt.Errorf("unmarshalling fields for racquet %s: %w", name, err)
+	}
+
+	for field, extractor := range s.job.Fields {
+		raw[field] = applyRegex(extractor, raw[field])
+	}
+
+	return raw, nil
 }
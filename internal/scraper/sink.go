@@ -0,0 +1,19 @@
+package scraper
+
+import "context"
+
+// Sink receives each RacquetSpecs as soon as it's scraped, so a long crawl
+// doesn't have to hold every result in memory (or lose everything) if the
+// browser dies partway through. Concrete implementations (CSV, JSON, JSONL,
+// SQLite, ...) live in the output package to avoid an import cycle.
+type Sink interface {
+	Write(ctx context.Context, specs RacquetSpecs) error
+	Close() error
+}
+
+// nopSink is the default when no sink has been configured: it discards
+// everything, matching the scraper's original print-only behavior.
+type nopSink struct{}
+
+func (nopSink) Write(ctx context.Context, specs RacquetSpecs) error { return nil }
+func (nopSink) Close() error                                        { return nil }
@@ -0,0 +1,55 @@
+package scraper
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Throttle is a simple token-bucket rate limiter that refills its whole bucket
+// at once: it allows at most Num calls to Wait per window, where a window
+// starts with the first call after the previous one has expired, blocking
+// callers once the bucket is exhausted until the window rolls over. It exists so worker-pool crawls don't hammer a
+// target site harder than --throttle-num/--throttle-duration allow.
+type Throttle struct {
+	mu      sync.Mutex
+	num     int
+	window  time.Duration
+	count   int
+	resetAt time.Time
+}
+
+// NewThrottle builds a Throttle permitting num calls per window. A num <= 0
+// disables throttling entirely.
+func NewThrottle(num int, window time.Duration) *Throttle {
+	return &Throttle{num: num, window: window}
+}
+
+// Wait blocks until a token is available or ctx is done.
+func (t *Throttle) Wait(ctx context.Context) error {
+	if t == nil || t.num <= 0 {
+		return nil
+	}
+
+	for {
+		t.mu.Lock()
+		now := time.Now()
+		if now.After(t.resetAt) {
+			t.count = 0
+			t.resetAt = now.Add(t.window)
+		}
+		if t.count < t.num {
+			t.count++
+			t.mu.Unlock()
+			return nil
+		}
+		wait := time.Until(t.resetAt)
+		t.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}